@@ -0,0 +1,49 @@
+package constant
+
+// ClientConfig is the set of options that control how the SDK talks to a
+// Nacos server, including how it authenticates.
+//
+// This only carries the fields common/security depends on; it is not a full
+// port of the SDK's client configuration.
+type ClientConfig struct {
+	// TimeoutMs is the per-request timeout for login, config, and naming
+	// HTTP calls.
+	TimeoutMs uint64
+	// Username is the Nacos account to log in with. Left empty, the client
+	// behaves as anonymous.
+	Username string
+	// Password is the Nacos account's password.
+	Password string
+
+	// AuthToken, when set, makes NewAuthenticator use a
+	// StaticTokenAuthenticator instead of logging in with Username/Password.
+	AuthToken string
+	// OIDCConfig, when set with a TokenEndpoint, makes NewAuthenticator use
+	// an OIDCAuthenticator instead of logging in with Username/Password.
+	OIDCConfig *OIDCConfig
+
+	// TokenRefreshWindow is how many seconds before token expiry
+	// NacosAuthClient schedules its next refresh. Defaults to 300 when <= 0.
+	TokenRefreshWindow int64
+	// TokenRefreshJitterPercent spreads the refreshes of many SDK instances
+	// started together across +/- this fraction of the refresh interval.
+	// Defaults to 0.1 when <= 0.
+	TokenRefreshJitterPercent float64
+}
+
+// OIDCConfig configures OIDCAuthenticator's OAuth2/OIDC client-credentials
+// flow.
+type OIDCConfig struct {
+	// TokenEndpoint is the OAuth2 token endpoint the client-credentials
+	// grant is POSTed to.
+	TokenEndpoint string
+	// ClientId and ClientSecret identify this SDK instance to the identity
+	// provider.
+	ClientId     string
+	ClientSecret string
+	// Scope, if set, is passed through to the token endpoint.
+	Scope string
+	// RefreshWindow is how many seconds before expiry OIDCAuthenticator
+	// fetches a new token. Defaults to 60 when <= 0.
+	RefreshWindow int64
+}
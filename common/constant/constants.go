@@ -0,0 +1,9 @@
+package constant
+
+// RESPONSE_CODE_SUCCESS is the HTTP status code Nacos returns for a
+// successful request.
+const RESPONSE_CODE_SUCCESS = 200
+
+// KEY_ACCESS_TOKEN is the key the access token is attached under in
+// GetSecurityInfo's returned params and Nacos request query params.
+const KEY_ACCESS_TOKEN = "accessToken"
@@ -0,0 +1,9 @@
+package constant
+
+// ServerConfig describes one Nacos server endpoint.
+type ServerConfig struct {
+	Scheme      string
+	IpAddr      string
+	Port        uint64
+	ContextPath string
+}
@@ -0,0 +1,28 @@
+package security
+
+import "time"
+
+// AuthEventType identifies a point in NacosAuthClient's login/refresh/revoke
+// lifecycle.
+type AuthEventType string
+
+const (
+	AuthEventLoginSuccess    AuthEventType = "LoginSuccess"
+	AuthEventLoginFailure    AuthEventType = "LoginFailure"
+	AuthEventTokenRefreshed  AuthEventType = "TokenRefreshed"
+	AuthEventTokenNearExpiry AuthEventType = "TokenNearExpiry"
+	AuthEventRevoked         AuthEventType = "Revoked"
+)
+
+// AuthEvent is published to every listener registered through
+// RegisterAuthListener. StatusCode and Err are only set for LoginFailure.
+type AuthEvent struct {
+	Type          AuthEventType
+	Timestamp     time.Time
+	ServerAddress string
+	StatusCode    int
+	Err           error
+}
+
+// AuthListener receives AuthEvents published by a NacosAuthClient.
+type AuthListener func(AuthEvent)
@@ -0,0 +1,22 @@
+package security
+
+import "github.com/nacos-group/nacos-sdk-go/v2/common/logger"
+
+// loggerAuthListener is registered on every NacosAuthClient by default so
+// auth lifecycle transitions show up in the SDK's usual logs without callers
+// having to wire anything up themselves.
+func loggerAuthListener(event AuthEvent) {
+	switch event.Type {
+	case AuthEventLoginSuccess:
+		logger.Infof("nacos auth: logged in to %s", event.ServerAddress)
+	case AuthEventTokenRefreshed:
+		logger.Infof("nacos auth: refreshed token from %s", event.ServerAddress)
+	case AuthEventTokenNearExpiry:
+		logger.Infof("nacos auth: token nearing expiry, refreshing")
+	case AuthEventLoginFailure:
+		logger.Errorf("nacos auth: login to %s failed with status %d: %v",
+			event.ServerAddress, event.StatusCode, event.Err)
+	case AuthEventRevoked:
+		logger.Infof("nacos auth: token revoked")
+	}
+}
@@ -0,0 +1,65 @@
+package security
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestResource describes the target of an outgoing request (which
+// namespace/group/resource it touches) so an Authenticator can decide
+// whether credentials are required and which ones to attach.
+type RequestResource struct {
+	Type      string
+	Namespace string
+	Group     string
+	Resource  string
+}
+
+// Authenticator abstracts how an SDK client proves its identity to a Nacos
+// server. NacosAuthClient (Nacos username/password), StaticTokenAuthenticator
+// (a pre-issued bearer token) and OIDCAuthenticator (OAuth2/OIDC client
+// credentials) all satisfy it, so gRPC and HTTP transports can attach
+// credentials to a request without knowing which flow is in use.
+type Authenticator interface {
+	// Authenticate makes sure a credential is available for resource,
+	// logging in or fetching a token if none exists yet. OIDCAuthenticator
+	// also fetches a new token once the current one is within its refresh
+	// window; NacosAuthClient leaves renewal of an existing token to
+	// AutoRefresh.
+	Authenticate(resource RequestResource) error
+	// GetSecurityInfo returns the request parameters carrying the current
+	// credential, e.g. constant.KEY_ACCESS_TOKEN.
+	GetSecurityInfo(resource RequestResource) map[string]string
+	// AutoRefresh starts a background goroutine that keeps the credential
+	// fresh until ctx is done.
+	AutoRefresh(ctx context.Context)
+	// ForceRefresh fetches a new credential right away, for callers that
+	// received a 401/403 mid-request and can't wait for the next scheduled
+	// refresh.
+	ForceRefresh(ctx context.Context) error
+	// RevokeToken invalidates the current credential on the server (if the
+	// underlying flow supports it), clears it locally and stops any
+	// AutoRefresh goroutine. Safe to call when no credential was ever
+	// obtained.
+	RevokeToken(ctx context.Context) error
+}
+
+// AuthenticationError wraps a failed authentication attempt together with
+// the underlying HTTP response, so callers can tell a network error apart
+// from a rejected credential, the way IBM's go-sdk-core does.
+type AuthenticationError struct {
+	StatusCode int
+	Body       string
+	Err        error
+}
+
+func (e *AuthenticationError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("authentication failed: %s", e.Err.Error())
+	}
+	return fmt.Sprintf("authentication failed: status %d: %s", e.StatusCode, e.Body)
+}
+
+func (e *AuthenticationError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,190 @@
+package security
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// CredentialProvider supplies the username/password NacosAuthClient should
+// log in with, fetched on demand rather than captured once at construction
+// time. This lets credentials rotate out from under a running client, the
+// way K8s projected service-account tokens and Vault-templated secrets do.
+type CredentialProvider interface {
+	Credentials() (username, password string, err error)
+}
+
+// ChangeNotifier is implemented by CredentialProviders that can proactively
+// signal a credential rotation, instead of only being polled on the next
+// login. NacosAuthClient uses it to invalidate the current token and
+// trigger an immediate refresh as soon as the underlying source changes.
+type ChangeNotifier interface {
+	// OnChange registers a callback to be invoked after Credentials()
+	// starts returning new values. At most one callback is kept.
+	OnChange(func())
+}
+
+// StaticProvider returns the username/password it was constructed with.
+// It is the default, matching the SDK's historical behavior of reading
+// credentials once from ClientConfig.
+type StaticProvider struct {
+	Username string
+	Password string
+}
+
+func NewStaticProvider(username, password string) *StaticProvider {
+	return &StaticProvider{Username: username, Password: password}
+}
+
+func (p *StaticProvider) Credentials() (string, string, error) {
+	return p.Username, p.Password, nil
+}
+
+// EnvProvider reads the username/password from environment variables on
+// every call, so rotating the process environment (or the env file a
+// supervisor injects it from) takes effect on the next login.
+type EnvProvider struct {
+	UsernameEnv string
+	PasswordEnv string
+}
+
+func NewEnvProvider(usernameEnv, passwordEnv string) *EnvProvider {
+	return &EnvProvider{UsernameEnv: usernameEnv, PasswordEnv: passwordEnv}
+}
+
+func (p *EnvProvider) Credentials() (string, string, error) {
+	return os.Getenv(p.UsernameEnv), os.Getenv(p.PasswordEnv), nil
+}
+
+// FileProvider reads "username\npassword" from a credentials file and
+// watches it with fsnotify, hot-reloading whenever the file is rewritten -
+// the common pattern for K8s projected service-account tokens and
+// Vault-templated secrets.
+type FileProvider struct {
+	path string
+
+	mu       sync.RWMutex
+	username string
+	password string
+	onChange func()
+
+	watcher *fsnotify.Watcher
+}
+
+// NewFileProvider starts watching path and returns a FileProvider once the
+// initial credentials have been loaded. Call Close to stop the watcher.
+//
+// It watches path's parent directory rather than path itself: K8s rotates a
+// projected secret by swapping the directory's "..data" symlink and
+// Vault-agent renders by writing a temp file and renaming it into place, so
+// the actual change lands as a Create/Rename on the directory, not a Write
+// on path - and fsnotify drops a watch on path outright once path itself is
+// removed or renamed out from under it.
+func NewFileProvider(path string) (*FileProvider, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &FileProvider{path: absPath}
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(filepath.Dir(absPath)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	p.watcher = watcher
+
+	go p.watch()
+	return p, nil
+}
+
+// watch reacts to every event fsnotify delivers for the watched directory,
+// not just Write/Create on our exact filename: a symlink-swap rotation
+// touches a different directory entry ("..data") than the path callers
+// read, so filtering by name or op would miss it. reload() re-resolves
+// path (through any symlink) each time, so this is safe even when the
+// triggering event was unrelated.
+func (p *FileProvider) watch() {
+	for {
+		select {
+		case _, ok := <-p.watcher.Events:
+			if !ok {
+				return
+			}
+			p.reloadAndNotify()
+		case _, ok := <-p.watcher.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+func (p *FileProvider) reloadAndNotify() {
+	p.mu.RLock()
+	prevUsername, prevPassword := p.username, p.password
+	p.mu.RUnlock()
+
+	if err := p.reload(); err != nil {
+		return
+	}
+
+	p.mu.RLock()
+	changed := p.username != prevUsername || p.password != prevPassword
+	onChange := p.onChange
+	p.mu.RUnlock()
+
+	if changed && onChange != nil {
+		onChange()
+	}
+}
+
+func (p *FileProvider) reload() error {
+	raw, err := os.ReadFile(p.path)
+	if err != nil {
+		return err
+	}
+	username, password := parseCredentialsFile(raw)
+
+	p.mu.Lock()
+	p.username, p.password = username, password
+	p.mu.Unlock()
+	return nil
+}
+
+func parseCredentialsFile(raw []byte) (username, password string) {
+	lines := strings.Split(string(raw), "\n")
+	if len(lines) > 0 {
+		username = strings.TrimRight(lines[0], "\r")
+	}
+	if len(lines) > 1 {
+		password = strings.TrimRight(lines[1], "\r")
+	}
+	return username, password
+}
+
+func (p *FileProvider) Credentials() (string, string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.username, p.password, nil
+}
+
+func (p *FileProvider) OnChange(cb func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onChange = cb
+}
+
+// Close stops the filesystem watcher.
+func (p *FileProvider) Close() error {
+	return p.watcher.Close()
+}
@@ -0,0 +1,123 @@
+package security
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticProvider_Credentials(t *testing.T) {
+	p := NewStaticProvider("alice", "alicepass")
+	username, password, err := p.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", username)
+	assert.Equal(t, "alicepass", password)
+}
+
+func TestEnvProvider_Credentials(t *testing.T) {
+	t.Setenv("NACOS_TEST_USERNAME", "alice")
+	t.Setenv("NACOS_TEST_PASSWORD", "alicepass")
+
+	p := NewEnvProvider("NACOS_TEST_USERNAME", "NACOS_TEST_PASSWORD")
+	username, password, err := p.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", username)
+	assert.Equal(t, "alicepass", password)
+
+	t.Setenv("NACOS_TEST_USERNAME", "bob")
+	username, _, err = p.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "bob", username)
+}
+
+func TestFileProvider_Credentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte("alice\nalicepass\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewFileProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	username, password, err := p.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", username)
+	assert.Equal(t, "alicepass", password)
+}
+
+func TestFileProvider_HotReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte("alice\nalicepass\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewFileProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	changed := make(chan struct{}, 1)
+	p.OnChange(func() { changed <- struct{}{} })
+
+	if err := os.WriteFile(path, []byte("bob\nbobpass\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("file rewrite did not trigger a reload")
+	}
+
+	username, password, err := p.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "bob", username)
+	assert.Equal(t, "bobpass", password)
+}
+
+// TestFileProvider_HotReload_AtomicRename exercises the rotation pattern
+// K8s (swapping the "..data" symlink) and Vault-agent (render-then-rename)
+// actually use: the credentials file is replaced by renaming a new file
+// over it, not rewritten in place.
+func TestFileProvider_HotReload_AtomicRename(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(path, []byte("alice\nalicepass\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewFileProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	changed := make(chan struct{}, 1)
+	p.OnChange(func() { changed <- struct{}{} })
+
+	tmp := filepath.Join(dir, "credentials.tmp")
+	if err := os.WriteFile(tmp, []byte("bob\nbobpass\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("atomic rename did not trigger a reload")
+	}
+
+	username, password, err := p.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "bob", username)
+	assert.Equal(t, "bobpass", password)
+}
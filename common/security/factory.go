@@ -0,0 +1,29 @@
+package security
+
+import (
+	"context"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+)
+
+// NewAuthenticator picks the Authenticator implementation to use for a
+// client from its ClientConfig, so HTTP and gRPC transports can share the
+// same selection logic instead of each hard-coding the Nacos login flow.
+//
+// Precedence: an OIDC endpoint, then a static token, then Nacos
+// username/password (the historical default, used even when username is
+// empty so the client behaves as anonymous).
+//
+// Callers own the returned Authenticator's lifecycle: start credentials
+// flowing with AutoRefresh once the transport is up, and call RevokeToken
+// from the client's teardown path (e.g. INamingClient/IConfigClient's
+// Close) so a logged-in token doesn't outlive the client that obtained it.
+func NewAuthenticator(ctx context.Context, clientCfg constant.ClientConfig, serverCfgs []constant.ServerConfig) Authenticator {
+	if clientCfg.OIDCConfig != nil && clientCfg.OIDCConfig.TokenEndpoint != "" {
+		return NewOIDCAuthenticator(clientCfg)
+	}
+	if clientCfg.AuthToken != "" {
+		return NewStaticTokenAuthenticator(clientCfg.AuthToken)
+	}
+	return NewNacosAuthClient(ctx, clientCfg, serverCfgs)
+}
@@ -0,0 +1,50 @@
+package security
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthenticator_Precedence(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientCfg constant.ClientConfig
+		want      interface{}
+	}{
+		{
+			name: "oidc endpoint wins over everything else",
+			clientCfg: constant.ClientConfig{
+				AuthToken: "static-token",
+				OIDCConfig: &constant.OIDCConfig{
+					TokenEndpoint: "https://idp.example.com/token",
+				},
+			},
+			want: &OIDCAuthenticator{},
+		},
+		{
+			name:      "static token wins over username/password",
+			clientCfg: constant.ClientConfig{AuthToken: "static-token", Username: "alice"},
+			want:      &StaticTokenAuthenticator{},
+		},
+		{
+			name:      "falls back to Nacos username/password",
+			clientCfg: constant.ClientConfig{Username: "alice", Password: "alicepass"},
+			want:      &NacosAuthClient{},
+		},
+		{
+			name:      "empty config falls back to Nacos anonymous login",
+			clientCfg: constant.ClientConfig{},
+			want:      &NacosAuthClient{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewAuthenticator(context.Background(), tt.clientCfg, nil)
+			assert.IsType(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package security
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	authLoginSuccessTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nacos_auth_login_success_total",
+		Help: "Total number of successful Nacos auth logins.",
+	})
+	authLoginFailuresTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nacos_auth_login_failures_total",
+		Help: "Total number of failed Nacos auth logins.",
+	})
+	authTokenRefreshedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nacos_auth_token_refreshed_total",
+		Help: "Total number of Nacos auth token refreshes.",
+	})
+	authRevokedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nacos_auth_revoked_total",
+		Help: "Total number of Nacos auth token revocations.",
+	})
+	authLastRefreshTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacos_auth_last_refresh_timestamp",
+		Help: "Unix timestamp of the last successful Nacos auth login or refresh.",
+	})
+)
+
+// metricsAuthListener updates the package's Prometheus metrics from each
+// AuthEvent so operators can alert on, e.g., nacos_auth_login_failures_total
+// spikes.
+func metricsAuthListener(event AuthEvent) {
+	switch event.Type {
+	case AuthEventLoginSuccess:
+		authLoginSuccessTotal.Inc()
+		authLastRefreshTimestamp.Set(float64(event.Timestamp.Unix()))
+	case AuthEventTokenRefreshed:
+		authTokenRefreshedTotal.Inc()
+		authLastRefreshTimestamp.Set(float64(event.Timestamp.Unix()))
+	case AuthEventLoginFailure:
+		authLoginFailuresTotal.Inc()
+	case AuthEventRevoked:
+		authRevokedTotal.Inc()
+	}
+}
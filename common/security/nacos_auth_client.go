@@ -0,0 +1,442 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+	"github.com/nacos-group/nacos-sdk-go/v2/common/http_agent"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultRefreshJitterPercent is applied when ClientConfig doesn't specify
+// one, spreading out refreshes from many SDK instances started together.
+const defaultRefreshJitterPercent = 0.1
+
+// maxRefreshBackoff caps the exponential backoff applied between failed
+// refresh attempts in AutoRefresh.
+const maxRefreshBackoff = 60 * time.Second
+
+// minRefreshDelay floors the scheduled refresh interval so a short-lived
+// token (tokenTtl <= tokenRefreshWindow) can't schedule a zero/negative
+// delay and spin AutoRefresh in a tight loop against /v1/auth/login.
+const minRefreshDelay = 5 * time.Second
+
+// NacosAuthClient implements Authenticator using Nacos's own
+// username/password login endpoint (POST /v1/auth/login). NewAuthenticator
+// picks it whenever ClientConfig sets neither an OIDC endpoint nor an
+// AuthToken, including when Username is empty.
+type NacosAuthClient struct {
+	ctx                context.Context
+	clientCfg          constant.ClientConfig
+	serverCfgs         []constant.ServerConfig
+	agent              http_agent.IHttpAgent
+	accessToken        *atomic.Value
+	tokenRefreshWindow int64
+
+	// mu guards every field below that login (called concurrently via
+	// singleflight from Authenticate/ForceRefresh/AutoRefresh) and
+	// AutoRefresh's timer goroutine both touch.
+	mu              sync.Mutex
+	tokenTtl        int64
+	lastRefreshTime int64
+	username        string
+	password        string
+	backoff         time.Duration
+
+	refreshGroup  singleflight.Group
+	jitterPercent float64
+
+	autoRefreshCancel context.CancelFunc
+
+	credentialProvider CredentialProvider
+
+	listenersMu  sync.RWMutex
+	listeners    []AuthListener
+	loggedInOnce bool
+}
+
+// credentials returns the username/password currently in use.
+func (client *NacosAuthClient) credentials() (string, string) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.username, client.password
+}
+
+// setCredentials replaces the username/password in use.
+func (client *NacosAuthClient) setCredentials(username, password string) {
+	client.mu.Lock()
+	client.username, client.password = username, password
+	client.mu.Unlock()
+}
+
+// hasUsername reports whether the client has a non-empty username, i.e.
+// isn't logging in anonymously.
+func (client *NacosAuthClient) hasUsername() bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return len(client.username) > 0
+}
+
+// recordLogin stores the ttl returned by a successful login and stamps the
+// refresh time.
+func (client *NacosAuthClient) recordLogin(tokenTtl int64) {
+	client.mu.Lock()
+	client.tokenTtl = tokenTtl
+	client.lastRefreshTime = time.Now().Unix()
+	client.mu.Unlock()
+}
+
+// touchRefreshTime stamps the refresh time without changing the ttl, for
+// the anonymous-login shortcut in login.
+func (client *NacosAuthClient) touchRefreshTime() {
+	client.mu.Lock()
+	client.lastRefreshTime = time.Now().Unix()
+	client.mu.Unlock()
+}
+
+// RegisterAuthListener adds listener to the set notified of every AuthEvent
+// this client publishes across login, refresh and revoke.
+func (client *NacosAuthClient) RegisterAuthListener(listener AuthListener) {
+	client.listenersMu.Lock()
+	defer client.listenersMu.Unlock()
+	client.listeners = append(client.listeners, listener)
+}
+
+func (client *NacosAuthClient) publish(event AuthEvent) {
+	client.listenersMu.RLock()
+	listeners := append([]AuthListener(nil), client.listeners...)
+	client.listenersMu.RUnlock()
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// SetCredentialProvider switches the client to pulling its username/password
+// from provider on every login instead of the static values captured at
+// construction time. If provider also implements ChangeNotifier, a
+// credential rotation invalidates the current token and triggers an
+// immediate refresh through the single-flight path.
+func (client *NacosAuthClient) SetCredentialProvider(provider CredentialProvider) {
+	client.credentialProvider = provider
+	if notifier, ok := provider.(ChangeNotifier); ok {
+		notifier.OnChange(func() {
+			client.accessToken.Store("")
+			go client.refresh()
+		})
+	}
+}
+
+// NewNacosAuthClient creates a NacosAuthClient for the given server list,
+// reading the Nacos username/password out of clientCfg.
+func NewNacosAuthClient(ctx context.Context, clientCfg constant.ClientConfig, serverCfgs []constant.ServerConfig) *NacosAuthClient {
+	tokenRefreshWindow := clientCfg.TokenRefreshWindow
+	if tokenRefreshWindow <= 0 {
+		tokenRefreshWindow = 300
+	}
+	jitterPercent := clientCfg.TokenRefreshJitterPercent
+	if jitterPercent <= 0 {
+		jitterPercent = defaultRefreshJitterPercent
+	}
+	client := &NacosAuthClient{
+		ctx:                ctx,
+		clientCfg:          clientCfg,
+		serverCfgs:         serverCfgs,
+		agent:              &http_agent.HttpAgent{},
+		accessToken:        &atomic.Value{},
+		tokenRefreshWindow: tokenRefreshWindow,
+		username:           clientCfg.Username,
+		password:           clientCfg.Password,
+		jitterPercent:      jitterPercent,
+	}
+	client.SetCredentialProvider(NewStaticProvider(clientCfg.Username, clientCfg.Password))
+	client.RegisterAuthListener(loggerAuthListener)
+	client.RegisterAuthListener(metricsAuthListener)
+	return client
+}
+
+// Authenticate implements Authenticator by making sure a login has
+// happened at least once; ongoing refreshes are left to AutoRefresh.
+func (client *NacosAuthClient) Authenticate(resource RequestResource) error {
+	if client.GetAccessToken() != "" || !client.hasUsername() {
+		return nil
+	}
+	_, err := client.refresh()
+	return err
+}
+
+// ForceRefresh logs in again right away, coalesced through the same
+// single-flight path as AutoRefresh. Callers that see a 401/403 mid-request
+// should use this instead of waiting for the next scheduled refresh.
+func (client *NacosAuthClient) ForceRefresh(ctx context.Context) error {
+	_, err := client.refresh()
+	return err
+}
+
+// refresh logs in against each configured server until one succeeds,
+// coalescing concurrent callers (e.g. many gRPC streams hitting
+// GetSecurityInfo at once) behind a single in-flight login.
+func (client *NacosAuthClient) refresh() (bool, error) {
+	v, err, _ := client.refreshGroup.Do("login", func() (interface{}, error) {
+		var lastErr error
+		for _, serverCfg := range client.serverCfgs {
+			ok, err := client.login(serverCfg)
+			if ok {
+				return true, nil
+			}
+			lastErr = err
+		}
+		return false, lastErr
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+// login performs a single login attempt against serverConfig, storing the
+// resulting access token on success. A client without a username is
+// considered anonymous and always succeeds without calling the server.
+func (client *NacosAuthClient) login(serverConfig constant.ServerConfig) (bool, error) {
+	if client.credentialProvider != nil {
+		username, password, err := client.credentialProvider.Credentials()
+		if err != nil {
+			return false, &AuthenticationError{Err: err}
+		}
+		client.setCredentials(username, password)
+	}
+
+	username, password := client.credentials()
+	if len(username) == 0 {
+		client.touchRefreshTime()
+		return true, nil
+	}
+
+	params := map[string]string{
+		"username": username,
+		"password": password,
+	}
+	header := http.Header{}
+	header["content-type"] = []string{"application/x-www-form-urlencoded"}
+
+	url := fmt.Sprintf("%s://%s:%d%s/v1/auth/login", serverConfig.Scheme,
+		serverConfig.IpAddr, serverConfig.Port, serverConfig.ContextPath)
+
+	response, err := client.agent.Post(url, header, uint64(client.clientCfg.TimeoutMs), params)
+	if err != nil {
+		client.publishLoginFailure(serverConfig, 0, err)
+		return false, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		client.publishLoginFailure(serverConfig, response.StatusCode, err)
+		return false, &AuthenticationError{StatusCode: response.StatusCode, Err: err}
+	}
+	if response.StatusCode != constant.RESPONSE_CODE_SUCCESS {
+		authErr := &AuthenticationError{StatusCode: response.StatusCode, Body: string(body)}
+		client.publishLoginFailure(serverConfig, response.StatusCode, authErr)
+		return false, authErr
+	}
+
+	var loginResp struct {
+		AccessToken string `json:"accessToken"`
+		TokenTtl    int64  `json:"tokenTtl"`
+	}
+	if err := json.Unmarshal(body, &loginResp); err != nil {
+		client.publishLoginFailure(serverConfig, response.StatusCode, err)
+		return false, &AuthenticationError{StatusCode: response.StatusCode, Err: err}
+	}
+
+	// atomic.Value.Store swaps the token in place, so a reader racing this
+	// refresh always sees either the old or the new token, never neither.
+	client.accessToken.Store(loginResp.AccessToken)
+	client.recordLogin(loginResp.TokenTtl)
+
+	eventType := AuthEventTokenRefreshed
+	if !client.loggedInOnce {
+		eventType = AuthEventLoginSuccess
+		client.loggedInOnce = true
+	}
+	client.publish(AuthEvent{
+		Type:          eventType,
+		Timestamp:     time.Now(),
+		ServerAddress: serverAddress(serverConfig),
+	})
+	return true, nil
+}
+
+func serverAddress(serverConfig constant.ServerConfig) string {
+	return fmt.Sprintf("%s:%d", serverConfig.IpAddr, serverConfig.Port)
+}
+
+func (client *NacosAuthClient) publishLoginFailure(serverConfig constant.ServerConfig, statusCode int, err error) {
+	client.publish(AuthEvent{
+		Type:          AuthEventLoginFailure,
+		Timestamp:     time.Now(),
+		ServerAddress: serverAddress(serverConfig),
+		StatusCode:    statusCode,
+		Err:           err,
+	})
+}
+
+// GetAccessToken returns the currently stored access token, or "" if the
+// client has never logged in (or logs in anonymously).
+func (client *NacosAuthClient) GetAccessToken() string {
+	token := client.accessToken.Load()
+	if token == nil {
+		return ""
+	}
+	return token.(string)
+}
+
+// GetSecurityInfo returns the access token as a request parameter so it can
+// be attached to outgoing config/naming requests.
+func (client *NacosAuthClient) GetSecurityInfo(resource RequestResource) map[string]string {
+	result := make(map[string]string, 1)
+	result[constant.KEY_ACCESS_TOKEN] = client.GetAccessToken()
+	return result
+}
+
+// AutoRefresh starts a background goroutine that re-logs in shortly before
+// the current token expires, stopping when ctx is done. The next refresh is
+// scheduled at tokenTtl-tokenRefreshWindow, jittered by jitterPercent so
+// that many SDK instances started together don't all hit the login endpoint
+// at once. A failed refresh is retried with capped exponential backoff
+// instead of being rescheduled on the normal cadence.
+func (client *NacosAuthClient) AutoRefresh(ctx context.Context) {
+	if !client.hasUsername() {
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	client.autoRefreshCancel = cancel
+	go func() {
+		timer := time.NewTimer(client.nextRefreshDelay())
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				client.publish(AuthEvent{Type: AuthEventTokenNearExpiry, Timestamp: time.Now()})
+				if ok, _ := client.refresh(); ok {
+					client.resetBackoff()
+					timer.Reset(client.nextRefreshDelay())
+				} else {
+					timer.Reset(client.nextBackoff())
+				}
+			}
+		}
+	}()
+}
+
+// nextRefreshDelay returns the scheduled refresh interval, jittered by
+// ±jitterPercent and floored at minRefreshDelay so a short tokenTtl can't
+// schedule a zero/negative delay and spin in a tight refresh loop.
+func (client *NacosAuthClient) nextRefreshDelay() time.Duration {
+	client.mu.Lock()
+	base := time.Duration(client.tokenTtl-client.tokenRefreshWindow) * time.Second
+	client.mu.Unlock()
+
+	if base < minRefreshDelay {
+		base = minRefreshDelay
+	}
+	if client.jitterPercent <= 0 {
+		return base
+	}
+	jitter := float64(base) * client.jitterPercent * (2*rand.Float64() - 1)
+	delay := base + time.Duration(jitter)
+	if delay < minRefreshDelay {
+		delay = minRefreshDelay
+	}
+	return delay
+}
+
+// RevokeToken posts to Nacos's logout endpoint so the current access token
+// can no longer be used, clears it locally, and stops AutoRefresh. If the
+// client holds no token, only AutoRefresh is stopped and the logout endpoint
+// is not called.
+func (client *NacosAuthClient) RevokeToken(ctx context.Context) error {
+	token := client.GetAccessToken()
+	if token == "" {
+		if client.autoRefreshCancel != nil {
+			client.autoRefreshCancel()
+		}
+		return nil
+	}
+
+	var lastErr error
+	for _, serverCfg := range client.serverCfgs {
+		if err := client.revokeAt(serverCfg, token); err != nil {
+			lastErr = err
+			continue
+		}
+		lastErr = nil
+		break
+	}
+
+	client.accessToken.Store("")
+	if lastErr == nil {
+		client.publish(AuthEvent{Type: AuthEventRevoked, Timestamp: time.Now()})
+	}
+	if client.autoRefreshCancel != nil {
+		client.autoRefreshCancel()
+	}
+	return lastErr
+}
+
+// Logout is an alias for RevokeToken for callers used to that name.
+func (client *NacosAuthClient) Logout(ctx context.Context) error {
+	return client.RevokeToken(ctx)
+}
+
+func (client *NacosAuthClient) revokeAt(serverCfg constant.ServerConfig, token string) error {
+	url := fmt.Sprintf("%s://%s:%d%s/v1/auth/logout", serverCfg.Scheme,
+		serverCfg.IpAddr, serverCfg.Port, serverCfg.ContextPath)
+	header := http.Header{}
+	header["content-type"] = []string{"application/x-www-form-urlencoded"}
+	params := map[string]string{"accessToken": token}
+
+	response, err := client.agent.Post(url, header, uint64(client.clientCfg.TimeoutMs), params)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != constant.RESPONSE_CODE_SUCCESS {
+		body, _ := io.ReadAll(response.Body)
+		return &AuthenticationError{StatusCode: response.StatusCode, Body: string(body)}
+	}
+	return nil
+}
+
+// nextBackoff returns the next capped exponential backoff to wait before
+// retrying a failed refresh.
+func (client *NacosAuthClient) nextBackoff() time.Duration {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.backoff <= 0 {
+		client.backoff = time.Second
+	} else {
+		client.backoff *= 2
+	}
+	if client.backoff > maxRefreshBackoff {
+		client.backoff = maxRefreshBackoff
+	}
+	return client.backoff
+}
+
+// resetBackoff clears the backoff after a successful refresh.
+func (client *NacosAuthClient) resetBackoff() {
+	client.mu.Lock()
+	client.backoff = 0
+	client.mu.Unlock()
+}
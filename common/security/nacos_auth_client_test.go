@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -263,6 +264,235 @@ func TestNacosAuthClient_GetSecurityInfo(t *testing.T) {
 	assert.Equal(t, "testtoken", info[constant.KEY_ACCESS_TOKEN])
 }
 
+func TestNacosAuthClient_RevokeToken(t *testing.T) {
+	serverConfigs := []constant.ServerConfig{{
+		Scheme:      "http",
+		IpAddr:      "localhost",
+		Port:        8848,
+		ContextPath: "/nacos",
+	}}
+
+	tests := []struct {
+		name         string
+		setupClient  func() *NacosAuthClient
+		mockResponse func() (*http.Response, error)
+		expectError  bool
+	}{
+		{
+			name: "successful revoke clears the token",
+			setupClient: func() *NacosAuthClient {
+				client := &NacosAuthClient{
+					accessToken: &atomic.Value{},
+					clientCfg:   constant.ClientConfig{TimeoutMs: 5000},
+					serverCfgs:  serverConfigs,
+				}
+				client.accessToken.Store("oldtoken")
+				return client
+			},
+			mockResponse: func() (*http.Response, error) {
+				return &http.Response{
+					StatusCode: constant.RESPONSE_CODE_SUCCESS,
+					Body:       io.NopCloser(bytes.NewBufferString("")),
+				}, nil
+			},
+			expectError: false,
+		},
+		{
+			name: "server-error revoke still clears the local token",
+			setupClient: func() *NacosAuthClient {
+				client := &NacosAuthClient{
+					accessToken: &atomic.Value{},
+					clientCfg:   constant.ClientConfig{TimeoutMs: 5000},
+					serverCfgs:  serverConfigs,
+				}
+				client.accessToken.Store("oldtoken")
+				return client
+			},
+			mockResponse: func() (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       io.NopCloser(bytes.NewBufferString("Internal Server Error")),
+				}, nil
+			},
+			expectError: true,
+		},
+		{
+			name: "revoke when no token present is a no-op",
+			setupClient: func() *NacosAuthClient {
+				return &NacosAuthClient{
+					accessToken: &atomic.Value{},
+					clientCfg:   constant.ClientConfig{TimeoutMs: 5000},
+					serverCfgs:  serverConfigs,
+				}
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := tt.setupClient()
+			if tt.mockResponse != nil {
+				client.agent = &MockHttpAgent{
+					PostFunc: func(url string, header http.Header, timeoutMs uint64, params map[string]string) (*http.Response, error) {
+						assert.Equal(t, "oldtoken", params["accessToken"])
+						return tt.mockResponse()
+					},
+				}
+			} else {
+				client.agent = &MockHttpAgent{
+					PostFunc: func(url string, header http.Header, timeoutMs uint64, params map[string]string) (*http.Response, error) {
+						t.Fatal("logout endpoint should not be called when there is no token")
+						return nil, nil
+					},
+				}
+			}
+
+			err := client.RevokeToken(context.Background())
+
+			if tt.expectError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, "", client.GetAccessToken())
+		})
+	}
+}
+
+type swappableProvider struct {
+	mu       sync.Mutex
+	username string
+	password string
+	onChange func()
+}
+
+func (p *swappableProvider) Credentials() (string, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.username, p.password, nil
+}
+
+func (p *swappableProvider) OnChange(cb func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onChange = cb
+}
+
+func (p *swappableProvider) swap(username, password string) {
+	p.mu.Lock()
+	p.username, p.password = username, password
+	onChange := p.onChange
+	p.mu.Unlock()
+	if onChange != nil {
+		onChange()
+	}
+}
+
+func TestNacosAuthClient_CredentialProviderRotation(t *testing.T) {
+	provider := &swappableProvider{username: "alice", password: "alicepass"}
+
+	client := &NacosAuthClient{
+		accessToken: &atomic.Value{},
+		clientCfg:   constant.ClientConfig{TimeoutMs: 5000},
+		serverCfgs: []constant.ServerConfig{{
+			Scheme:      "http",
+			IpAddr:      "localhost",
+			Port:        8848,
+			ContextPath: "/nacos",
+		}},
+	}
+	client.accessToken.Store("alicetoken")
+	client.SetCredentialProvider(provider)
+
+	client.agent = &MockHttpAgent{
+		PostFunc: func(url string, header http.Header, timeoutMs uint64, params map[string]string) (*http.Response, error) {
+			assert.Equal(t, "bob", params["username"])
+			resp := map[string]interface{}{
+				"accessToken": "bobtoken",
+				"tokenTtl":    float64(3600),
+			}
+			jsonBytes, _ := json.Marshal(resp)
+			return &http.Response{
+				StatusCode: constant.RESPONSE_CODE_SUCCESS,
+				Body:       io.NopCloser(bytes.NewBuffer(jsonBytes)),
+			}, nil
+		},
+	}
+
+	provider.swap("bob", "bobpass")
+
+	deadline := time.Now().Add(time.Second)
+	for client.GetAccessToken() != "bobtoken" {
+		if time.Now().After(deadline) {
+			t.Fatal("credential rotation did not trigger a refresh")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestNacosAuthClient_AuthEventOrdering(t *testing.T) {
+	serverConfig := constant.ServerConfig{
+		Scheme:      "http",
+		IpAddr:      "localhost",
+		Port:        8848,
+		ContextPath: "/nacos",
+	}
+
+	client := &NacosAuthClient{
+		username:    "testuser",
+		password:    "testpass",
+		accessToken: &atomic.Value{},
+		clientCfg:   constant.ClientConfig{TimeoutMs: 5000},
+		serverCfgs:  []constant.ServerConfig{serverConfig},
+	}
+
+	statusCode := constant.RESPONSE_CODE_SUCCESS
+	client.agent = &MockHttpAgent{
+		PostFunc: func(url string, header http.Header, timeoutMs uint64, params map[string]string) (*http.Response, error) {
+			resp := map[string]interface{}{
+				"accessToken": "token",
+				"tokenTtl":    float64(3600),
+			}
+			jsonBytes, _ := json.Marshal(resp)
+			return &http.Response{
+				StatusCode: statusCode,
+				Body:       io.NopCloser(bytes.NewBuffer(jsonBytes)),
+			}, nil
+		},
+	}
+
+	var mu sync.Mutex
+	var events []AuthEventType
+	client.RegisterAuthListener(func(event AuthEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event.Type)
+	})
+
+	_, err := client.login(serverConfig)
+	assert.Nil(t, err)
+
+	_, err = client.login(serverConfig)
+	assert.Nil(t, err)
+
+	statusCode = http.StatusInternalServerError
+	_, err = client.login(serverConfig)
+	assert.NotNil(t, err)
+
+	statusCode = constant.RESPONSE_CODE_SUCCESS
+	assert.Nil(t, client.RevokeToken(context.Background()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []AuthEventType{
+		AuthEventLoginSuccess,
+		AuthEventTokenRefreshed,
+		AuthEventLoginFailure,
+		AuthEventRevoked,
+	}, events)
+}
+
 func TestNacosAuthClient_AutoRefresh(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,46 @@
+package security
+
+import (
+	"context"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+)
+
+// StaticTokenAuthenticator is an Authenticator for deployments that already
+// hold a long-lived bearer token (e.g. issued out-of-band by an API gateway)
+// and have no use for Nacos's username/password login flow.
+type StaticTokenAuthenticator struct {
+	token string
+}
+
+// NewStaticTokenAuthenticator returns an Authenticator that always presents
+// token, never contacting a login endpoint.
+func NewStaticTokenAuthenticator(token string) *StaticTokenAuthenticator {
+	return &StaticTokenAuthenticator{token: token}
+}
+
+func (a *StaticTokenAuthenticator) Authenticate(resource RequestResource) error {
+	return nil
+}
+
+func (a *StaticTokenAuthenticator) GetSecurityInfo(resource RequestResource) map[string]string {
+	return map[string]string{
+		constant.KEY_ACCESS_TOKEN: a.token,
+	}
+}
+
+// AutoRefresh is a no-op: a static token never expires from the SDK's point
+// of view and is rotated by whoever issued it.
+func (a *StaticTokenAuthenticator) AutoRefresh(ctx context.Context) {
+}
+
+// ForceRefresh is a no-op for the same reason.
+func (a *StaticTokenAuthenticator) ForceRefresh(ctx context.Context) error {
+	return nil
+}
+
+// RevokeToken is a no-op: the SDK doesn't own the lifecycle of a token
+// supplied out-of-band.
+func (a *StaticTokenAuthenticator) RevokeToken(ctx context.Context) error {
+	return nil
+}